@@ -0,0 +1,112 @@
+// グラフ操作
+// 隣接リスト・隣接行列のパースとフォーマット
+
+package leetcode
+
+import (
+	"encoding/json"
+)
+
+// ========================================
+// データ構造定義
+// ========================================
+
+// Edge 重み付きグラフの辺
+type Edge struct {
+	From int
+	To   int
+	W    int
+}
+
+// Graph 隣接リスト形式のグラフ。Edges[i]はノードiから出る辺の一覧。
+// Directedがfalseの場合、各無向辺は両端のEdgesに（u→v, v→u の2本として）
+// 格納される。自己ループ（u==v）は1本だけ格納される
+type Graph struct {
+	N        int
+	Directed bool
+	Edges    [][]Edge
+}
+
+// ========================================
+// パース関数
+// ========================================
+
+// ParseEdgeList LeetCode形式の辺リスト（[[u,v,w],...]、wは省略時1）をパースする
+func ParseEdgeList(s string, n int, directed bool) *Graph {
+	raw := ParseIntMatrix(s)
+
+	g := &Graph{N: n, Directed: directed, Edges: make([][]Edge, n)}
+	for _, e := range raw {
+		if len(e) < 2 {
+			continue
+		}
+
+		u, v := e[0], e[1]
+		w := 1
+		if len(e) >= 3 {
+			w = e[2]
+		}
+
+		g.Edges[u] = append(g.Edges[u], Edge{From: u, To: v, W: w})
+		if !directed && u != v {
+			g.Edges[v] = append(g.Edges[v], Edge{From: v, To: u, W: w})
+		}
+	}
+
+	return g
+}
+
+// ParseAdjMatrix n x nの隣接行列をパースする。0は辺なしを表す
+func ParseAdjMatrix(s string) *Graph {
+	matrix := ParseIntMatrix(s)
+
+	n := len(matrix)
+	g := &Graph{N: n, Directed: true, Edges: make([][]Edge, n)}
+	for i, row := range matrix {
+		for j, w := range row {
+			if w != 0 {
+				g.Edges[i] = append(g.Edges[i], Edge{From: i, To: j, W: w})
+			}
+		}
+	}
+
+	return g
+}
+
+// ========================================
+// 出力フォーマット
+// ========================================
+
+// FormatEdgeList グラフをLeetCode形式の辺リストにフォーマットする。
+// 無向グラフ（Directed==false）はu→v, v→uの2本が格納されているため、
+// From<=Toの辺だけを採用して元の本数に戻す
+func FormatEdgeList(g *Graph) string {
+	result := [][]int{}
+	for _, edges := range g.Edges {
+		for _, e := range edges {
+			if !g.Directed && e.From > e.To {
+				continue
+			}
+			result = append(result, []int{e.From, e.To, e.W})
+		}
+	}
+
+	b, _ := json.Marshal(result)
+	return string(b)
+}
+
+// FormatAdjMatrix グラフをn x nの隣接行列にフォーマットする
+func FormatAdjMatrix(g *Graph) string {
+	matrix := make([][]int, g.N)
+	for i := range matrix {
+		matrix[i] = make([]int, g.N)
+	}
+
+	for _, edges := range g.Edges {
+		for _, e := range edges {
+			matrix[e.From][e.To] = e.W
+		}
+	}
+
+	return FormatIntMatrix(matrix)
+}
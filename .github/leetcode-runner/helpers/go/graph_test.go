@@ -0,0 +1,75 @@
+package leetcode
+
+import "testing"
+
+func TestParseEdgeListDirected(t *testing.T) {
+	g := ParseEdgeList("[[0,1,5],[1,2,3]]", 3, true)
+
+	if g.N != 3 || !g.Directed {
+		t.Fatalf("unexpected graph: %+v", g)
+	}
+	if len(g.Edges[0]) != 1 || g.Edges[0][0] != (Edge{From: 0, To: 1, W: 5}) {
+		t.Fatalf("unexpected edges for node 0: %+v", g.Edges[0])
+	}
+	if len(g.Edges[1]) != 1 || g.Edges[1][0] != (Edge{From: 1, To: 2, W: 3}) {
+		t.Fatalf("unexpected edges for node 1: %+v", g.Edges[1])
+	}
+}
+
+func TestParseEdgeListUndirectedStoresBothDirections(t *testing.T) {
+	g := ParseEdgeList("[[0,1,5]]", 2, false)
+
+	if len(g.Edges[0]) != 1 || len(g.Edges[1]) != 1 {
+		t.Fatalf("expected one stored edge per endpoint, got %+v", g.Edges)
+	}
+	if g.Edges[0][0] != (Edge{From: 0, To: 1, W: 5}) {
+		t.Fatalf("unexpected forward edge: %+v", g.Edges[0][0])
+	}
+	if g.Edges[1][0] != (Edge{From: 1, To: 0, W: 5}) {
+		t.Fatalf("unexpected reciprocal edge: %+v", g.Edges[1][0])
+	}
+}
+
+func TestParseEdgeListUndirectedSelfLoopNotDuplicated(t *testing.T) {
+	g := ParseEdgeList("[[0,0,1]]", 1, false)
+
+	if len(g.Edges[0]) != 1 {
+		t.Fatalf("expected a self-loop to be stored once, got %+v", g.Edges[0])
+	}
+}
+
+func TestFormatEdgeListRoundTripsUndirectedGraph(t *testing.T) {
+	g := ParseEdgeList("[[0,1,5],[1,2,3]]", 3, false)
+
+	got := FormatEdgeList(g)
+	want := `[[0,1,5],[1,2,3]]`
+	if got != want {
+		t.Fatalf("FormatEdgeList(%+v) = %q, want %q", g, got, want)
+	}
+}
+
+func TestFormatEdgeListRoundTripsDirectedGraph(t *testing.T) {
+	g := ParseEdgeList("[[0,1,5],[1,2,3]]", 3, true)
+
+	got := FormatEdgeList(g)
+	want := `[[0,1,5],[1,2,3]]`
+	if got != want {
+		t.Fatalf("FormatEdgeList(%+v) = %q, want %q", g, got, want)
+	}
+}
+
+func TestParseAdjMatrixAndFormat(t *testing.T) {
+	matrix := "[[0,5,0],[0,0,3],[0,0,0]]"
+	g := ParseAdjMatrix(matrix)
+
+	if g.N != 3 || !g.Directed {
+		t.Fatalf("unexpected graph: %+v", g)
+	}
+	if len(g.Edges[0]) != 1 || g.Edges[0][0] != (Edge{From: 0, To: 1, W: 5}) {
+		t.Fatalf("unexpected edges for node 0: %+v", g.Edges[0])
+	}
+
+	if got := FormatAdjMatrix(g); got != matrix {
+		t.Fatalf("FormatAdjMatrix(g) = %q, want %q", got, matrix)
+	}
+}
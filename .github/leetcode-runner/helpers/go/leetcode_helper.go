@@ -296,6 +296,8 @@ func FormatOutput(val interface{}) string {
 		return FormatList(v)
 	case *TreeNode:
 		return FormatTree(v)
+	case *Graph:
+		return FormatEdgeList(v)
 	case bool:
 		return FormatBool(v)
 	case []int:
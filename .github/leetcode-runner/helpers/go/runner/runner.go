@@ -0,0 +1,112 @@
+// Runner for Go
+// stdin/stdoutを使った競技プログラミング形式の実行ハーネス
+
+package runner
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	leetcode "github.com/test-aitest/test-codehorse/.github/leetcode-runner/helpers/go"
+)
+
+// よく使う定数
+const (
+	INF          = 1 << 60
+	Mod1e9_7     = 1_000_000_007
+	Mod998244353 = 998_244_353
+)
+
+const maxScanBufSize = 1 << 24
+
+// IO 標準入出力をまとめたバッファ付きI/Oハンドル
+type IO struct {
+	scanner *bufio.Scanner
+	writer  *bufio.Writer
+}
+
+// newIO 標準入出力に接続したIOを生成
+func newIO() *IO {
+	scanner := bufio.NewScanner(os.Stdin)
+	scanner.Buffer(make([]byte, 1024), maxScanBufSize)
+	scanner.Split(bufio.ScanWords)
+
+	return &IO{
+		scanner: scanner,
+		writer:  bufio.NewWriter(os.Stdout),
+	}
+}
+
+// Flush 出力バッファを書き出す
+func (io *IO) Flush() {
+	io.writer.Flush()
+}
+
+// next 次のトークンを読む
+func (io *IO) next() string {
+	if !io.scanner.Scan() {
+		return ""
+	}
+	return io.scanner.Text()
+}
+
+// NextInt 次のトークンを整数として読む
+func (io *IO) NextInt() int {
+	return leetcode.ParseInt(io.next())
+}
+
+// NextInts 次のn個のトークンを整数として読む
+func (io *IO) NextInts(n int) []int {
+	result := make([]int, n)
+	for i := 0; i < n; i++ {
+		result[i] = io.NextInt()
+	}
+	return result
+}
+
+// NextString 次のトークンを文字列として読む
+func (io *IO) NextString() string {
+	return io.next()
+}
+
+// NextMatrix r行c列の整数行列を読む
+func (io *IO) NextMatrix(r, c int) [][]int {
+	matrix := make([][]int, r)
+	for i := 0; i < r; i++ {
+		matrix[i] = io.NextInts(c)
+	}
+	return matrix
+}
+
+// NextTree LeetCode形式の配列を読んで二分木を組み立てる
+func (io *IO) NextTree() *leetcode.TreeNode {
+	return leetcode.ParseTree(io.next())
+}
+
+// NextList 整数配列を読んでリンクリストを組み立てる
+func (io *IO) NextList() *leetcode.ListNode {
+	return leetcode.SliceToList(leetcode.ParseIntList(io.next()))
+}
+
+// Print IOの出力バッファへ書き込む
+func (io *IO) Print(a ...interface{}) {
+	fmt.Fprint(io.writer, a...)
+}
+
+// Println IOの出力バッファへ改行付きで書き込む
+func (io *IO) Println(a ...interface{}) {
+	fmt.Fprintln(io.writer, a...)
+}
+
+// Printf IOの出力バッファへ書式付きで書き込む
+func (io *IO) Printf(format string, a ...interface{}) {
+	fmt.Fprintf(io.writer, format, a...)
+}
+
+// Run stdin/stdoutに接続したIOを組み立ててsolveを実行し、終了時にFlushする
+func Run(solve func(*IO)) {
+	io := newIO()
+	defer io.Flush()
+	solve(io)
+}
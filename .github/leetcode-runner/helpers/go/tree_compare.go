@@ -0,0 +1,195 @@
+// Tree/List比較ヘルパー
+// フォーマット済み文字列ではなく構造そのものを比較するためのユーティリティ
+
+package leetcode
+
+import "fmt"
+
+// ========================================
+// リンクリスト比較
+// ========================================
+
+// EqualList 2つのリンクリストが同じ値列かどうかを判定する
+// Floydのサイクル検出（slow/fast）で各リストの前置き長・サイクル長を求め、
+// headを含まないサイクル（rho字型）を渡してもハングしない。
+//
+// 周期prefixA/cycleAとprefixB/cycleBを持つ2つの無限列が先頭から
+// max(prefixA,prefixB)+cycleA+cycleB項一致すれば、Fine–Wilfの定理より
+// それ以降も恒等に一致する。cycleA+cycleBではなくmax(cycleA,cycleB)までしか
+// 見ない比較は、周期の異なる循環列を誤って等しいと判定しうる
+func EqualList(a, b *ListNode) bool {
+	prefixA, cycleA := listShape(a)
+	prefixB, cycleB := listShape(b)
+
+	prefix := prefixA
+	if prefixB > prefix {
+		prefix = prefixB
+	}
+	limit := prefix + cycleA + cycleB
+
+	af, bf := a, b
+	for i := 0; i <= limit; i++ {
+		if af == nil || bf == nil {
+			return af == nil && bf == nil
+		}
+		if af.Val != bf.Val {
+			return false
+		}
+		af = af.Next
+		bf = bf.Next
+	}
+
+	// ここまで食い違いなく進めたので、両リストともここで循環に入っている
+	return true
+}
+
+// listShape リストの前置き長とサイクル長を返す。非循環なら(長さ, 0)を返す
+func listShape(head *ListNode) (prefixLen, cycleLen int) {
+	slow, fast := head, head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+
+		if slow == fast {
+			cycleStart := head
+			for cycleStart != slow {
+				cycleStart = cycleStart.Next
+				slow = slow.Next
+			}
+
+			for p := head; p != cycleStart; p = p.Next {
+				prefixLen++
+			}
+
+			cycleLen = 1
+			for p := cycleStart.Next; p != cycleStart; p = p.Next {
+				cycleLen++
+			}
+
+			return prefixLen, cycleLen
+		}
+	}
+
+	for p := head; p != nil; p = p.Next {
+		prefixLen++
+	}
+	return prefixLen, 0
+}
+
+// CloneList リンクリストを深いコピーする
+func CloneList(head *ListNode) *ListNode {
+	if head == nil {
+		return nil
+	}
+
+	clone := &ListNode{Val: head.Val}
+	cur := clone
+	for node := head.Next; node != nil; node = node.Next {
+		cur.Next = &ListNode{Val: node.Val}
+		cur = cur.Next
+	}
+
+	return clone
+}
+
+// ========================================
+// 二分木比較
+// ========================================
+
+// EqualTree 2つの二分木が同じ形・同じ値かどうかを判定する
+func EqualTree(a, b *TreeNode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Val == b.Val && EqualTree(a.Left, b.Left) && EqualTree(a.Right, b.Right)
+}
+
+// TreeHeight 二分木の高さ（ノードが1つなら1、空なら0）を返す
+func TreeHeight(root *TreeNode) int {
+	if root == nil {
+		return 0
+	}
+	return 1 + max(TreeHeight(root.Left), TreeHeight(root.Right))
+}
+
+// TreeSize 二分木のノード数を返す
+func TreeSize(root *TreeNode) int {
+	if root == nil {
+		return 0
+	}
+	return 1 + TreeSize(root.Left) + TreeSize(root.Right)
+}
+
+// IsBST 二分探索木の条件を満たしているかどうかを判定する
+func IsBST(root *TreeNode) bool {
+	return isBSTInRange(root, nil, nil)
+}
+
+func isBSTInRange(node *TreeNode, lo, hi *int) bool {
+	if node == nil {
+		return true
+	}
+	if lo != nil && node.Val <= *lo {
+		return false
+	}
+	if hi != nil && node.Val >= *hi {
+		return false
+	}
+	return isBSTInRange(node.Left, lo, &node.Val) && isBSTInRange(node.Right, &node.Val, hi)
+}
+
+// CloneTree 二分木を深いコピーする
+func CloneTree(root *TreeNode) *TreeNode {
+	if root == nil {
+		return nil
+	}
+	return &TreeNode{
+		Val:   root.Val,
+		Left:  CloneTree(root.Left),
+		Right: CloneTree(root.Right),
+	}
+}
+
+// DiffTree expectedとgotをBFS順に歩き、食い違うノードごとに
+// "expected X, got Y" を並べたレポートを返す。一致していれば空文字列を返す
+func DiffTree(expected, got *TreeNode) string {
+	type pair struct {
+		expected, got *TreeNode
+	}
+
+	var diffs []string
+	queue := []pair{{expected, got}}
+
+	for i := 0; len(queue) > 0; i++ {
+		p := queue[0]
+		queue = queue[1:]
+
+		switch {
+		case p.expected == nil && p.got == nil:
+			continue
+		case p.expected == nil:
+			diffs = append(diffs, fmt.Sprintf("node %d: expected nil, got %d", i, p.got.Val))
+			continue
+		case p.got == nil:
+			diffs = append(diffs, fmt.Sprintf("node %d: expected %d, got nil", i, p.expected.Val))
+			continue
+		case p.expected.Val != p.got.Val:
+			diffs = append(diffs, fmt.Sprintf("node %d: expected %d, got %d", i, p.expected.Val, p.got.Val))
+		}
+
+		queue = append(queue, pair{p.expected.Left, p.got.Left}, pair{p.expected.Right, p.got.Right})
+	}
+
+	if len(diffs) == 0 {
+		return ""
+	}
+
+	result := ""
+	for i, d := range diffs {
+		if i > 0 {
+			result += "\n"
+		}
+		result += d
+	}
+	return result
+}
@@ -0,0 +1,109 @@
+package leetcode
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEqualListAcyclic(t *testing.T) {
+	a := SliceToList([]int{1, 2, 3})
+	b := SliceToList([]int{1, 2, 3})
+	if !EqualList(a, b) {
+		t.Fatal("expected equal acyclic lists to compare equal")
+	}
+
+	c := SliceToList([]int{1, 2, 4})
+	if EqualList(a, c) {
+		t.Fatal("expected lists with differing values to compare unequal")
+	}
+
+	d := SliceToList([]int{1, 2})
+	if EqualList(a, d) {
+		t.Fatal("expected lists of differing length to compare unequal")
+	}
+}
+
+// cyclicList builds a list of the given values where the last node points
+// back to values[cycleStart], so the cycle need not include the head.
+func cyclicList(values []int, cycleStart int) *ListNode {
+	nodes := make([]*ListNode, len(values))
+	for i, v := range values {
+		nodes[i] = &ListNode{Val: v}
+	}
+	for i := 0; i < len(nodes)-1; i++ {
+		nodes[i].Next = nodes[i+1]
+	}
+	nodes[len(nodes)-1].Next = nodes[cycleStart]
+	return nodes[0]
+}
+
+func TestEqualListRhoShapeDoesNotHang(t *testing.T) {
+	a := cyclicList([]int{1, 2, 3, 4}, 1) // cycle is 2->3->4->2, head not in the cycle
+	b := cyclicList([]int{1, 2, 3, 4}, 1)
+
+	done := make(chan bool, 1)
+	go func() { done <- EqualList(a, b) }()
+
+	select {
+	case equal := <-done:
+		if !equal {
+			t.Fatal("expected identical rho-shaped lists to compare equal")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("EqualList hung on a rho-shaped cyclic list")
+	}
+}
+
+func TestEqualListDifferingCycleLengths(t *testing.T) {
+	// Purely cyclic lists with different cycle lengths: their infinite value
+	// streams diverge (index 7 is 0 vs 1) even though a naive
+	// max(cycleA,cycleB)-sized window wouldn't catch it.
+	a := cyclicList([]int{0, 1, 0, 0}, 0)
+	b := cyclicList([]int{0, 1, 0, 0, 0, 1}, 0)
+
+	if EqualList(a, b) {
+		t.Fatal("expected cyclic lists with different cycle lengths to compare unequal")
+	}
+}
+
+func TestEqualTree(t *testing.T) {
+	one, two, three := 1, 2, 3
+	a := SliceToTree([]*int{&one, &two, &three})
+	b := SliceToTree([]*int{&one, &two, &three})
+	if !EqualTree(a, b) {
+		t.Fatal("expected identically shaped trees to compare equal")
+	}
+
+	c := SliceToTree([]*int{&one, &two})
+	if EqualTree(a, c) {
+		t.Fatal("expected trees of differing shape to compare unequal")
+	}
+}
+
+func TestDiffTree(t *testing.T) {
+	one, two, three, four := 1, 2, 3, 4
+	expected := SliceToTree([]*int{&one, &two, &three})
+	got := SliceToTree([]*int{&one, &two, &four})
+
+	if diff := DiffTree(expected, expected); diff != "" {
+		t.Fatalf("expected no diff for identical trees, got %q", diff)
+	}
+
+	diff := DiffTree(expected, got)
+	if diff == "" {
+		t.Fatal("expected a non-empty diff for mismatched trees")
+	}
+}
+
+func TestIsBST(t *testing.T) {
+	two, one, three := 2, 1, 3
+	valid := SliceToTree([]*int{&two, &one, &three})
+	if !IsBST(valid) {
+		t.Fatal("expected a valid BST to pass IsBST")
+	}
+
+	invalid := SliceToTree([]*int{&one, &two, &three})
+	if IsBST(invalid) {
+		t.Fatal("expected an invalid BST to fail IsBST")
+	}
+}
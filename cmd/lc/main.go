@@ -0,0 +1,37 @@
+// Command lc runs a single registered LeetCode solution against
+// command-line input, e.g.:
+//
+//	lc --problem 1 --input "[2,7,11,15]\n9"
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/test-aitest/test-codehorse/problems"
+)
+
+func main() {
+	id := flag.Int("problem", 0, "LeetCode problem ID to run")
+	input := flag.String("input", "", "solution arguments, one per line")
+	flag.Parse()
+
+	p, ok := problems.Get(*id)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "lc: no solution registered for problem %d\n", *id)
+		os.Exit(1)
+	}
+
+	// A literal `\n` (as typed inside double quotes in a shell) also
+	// separates arguments, so the example above works as written.
+	inputs := strings.Split(strings.ReplaceAll(*input, `\n`, "\n"), "\n")
+	out, err := p.Invoke(inputs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "lc: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(out)
+}
@@ -0,0 +1,58 @@
+package problems
+
+import (
+	"fmt"
+
+	leetcode "github.com/test-aitest/test-codehorse/.github/leetcode-runner/helpers/go"
+)
+
+// containerWithMostWater implements LeetCode #11, Container With Most Water.
+type containerWithMostWater struct{}
+
+func init() {
+	Register(containerWithMostWater{})
+}
+
+func (containerWithMostWater) ID() int { return 11 }
+
+func (containerWithMostWater) Name() string { return "Container With Most Water" }
+
+func (containerWithMostWater) Signature() FuncSig {
+	return FuncSig{
+		Params:  []string{"[]int"},
+		Returns: []string{"int"},
+	}
+}
+
+func (containerWithMostWater) Invoke(inputs []string) (string, error) {
+	if len(inputs) != 1 {
+		return "", fmt.Errorf("max area: expected 1 input, got %d", len(inputs))
+	}
+
+	height := leetcode.ParseIntList(inputs[0])
+
+	return leetcode.FormatOutput(solveMaxArea(height)), nil
+}
+
+func (containerWithMostWater) Func() interface{} { return solveMaxArea }
+
+func solveMaxArea(height []int) int {
+	l := 0
+	r := len(height) - 1
+	maxarea := 0
+
+	for l < r {
+		length := min(height[l], height[r])
+		width := r - l
+		area := length * width
+		maxarea = max(maxarea, area)
+
+		if height[l] < height[r] {
+			l++
+		} else {
+			r--
+		}
+	}
+
+	return maxarea
+}
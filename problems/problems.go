@@ -0,0 +1,59 @@
+// Package problems provides a registry of LeetCode solutions that can be
+// dispatched by ID, so a single canonical implementation can be driven from
+// the cmd/lc CLI, the testrunner package, or ad-hoc callers alike.
+package problems
+
+import "fmt"
+
+// FuncSig describes the parameter and return types of a solution method, in
+// the same vocabulary the parsers in the leetcode package understand
+// ([]int, [][]int, *ListNode, *TreeNode, int, string, bool, ...).
+type FuncSig struct {
+	Params  []string
+	Returns []string
+}
+
+// Problem is a single registered LeetCode solution.
+type Problem interface {
+	// ID is the LeetCode problem number, e.g. 1 for Two Sum.
+	ID() int
+	// Name is the human-readable problem title.
+	Name() string
+	// Signature describes the solution method's parameter and return types.
+	Signature() FuncSig
+	// Invoke parses inputs (one raw string per argument, in declaration
+	// order), runs the solution, and returns the formatted result.
+	Invoke(inputs []string) (string, error)
+	// Func returns the raw solution function (e.g. func([]int, int) []int),
+	// so callers like testrunner can reflectively coerce arguments against
+	// Signature().Params themselves instead of going through Invoke.
+	Func() interface{}
+}
+
+var registry = map[int]Problem{}
+
+// Register adds p to the global registry. It is meant to be called from an
+// init() function in the file that implements the problem, and panics on a
+// duplicate ID since that indicates two solutions were registered for the
+// same problem.
+func Register(p Problem) {
+	if _, exists := registry[p.ID()]; exists {
+		panic(fmt.Sprintf("problems: solution already registered for id %d", p.ID()))
+	}
+	registry[p.ID()] = p
+}
+
+// Get looks up a registered problem by ID.
+func Get(id int) (Problem, bool) {
+	p, ok := registry[id]
+	return p, ok
+}
+
+// All returns every registered problem, in no particular order.
+func All() []Problem {
+	result := make([]Problem, 0, len(registry))
+	for _, p := range registry {
+		result = append(result, p)
+	}
+	return result
+}
@@ -0,0 +1,49 @@
+package problems
+
+import (
+	"fmt"
+
+	leetcode "github.com/test-aitest/test-codehorse/.github/leetcode-runner/helpers/go"
+)
+
+// twoSum implements LeetCode #1, Two Sum.
+type twoSum struct{}
+
+func init() {
+	Register(twoSum{})
+}
+
+func (twoSum) ID() int { return 1 }
+
+func (twoSum) Name() string { return "Two Sum" }
+
+func (twoSum) Signature() FuncSig {
+	return FuncSig{
+		Params:  []string{"[]int", "int"},
+		Returns: []string{"[]int"},
+	}
+}
+
+func (twoSum) Invoke(inputs []string) (string, error) {
+	if len(inputs) != 2 {
+		return "", fmt.Errorf("two sum: expected 2 inputs, got %d", len(inputs))
+	}
+
+	nums := leetcode.ParseIntList(inputs[0])
+	target := leetcode.ParseInt(inputs[1])
+
+	return leetcode.FormatOutput(solveTwoSum(nums, target)), nil
+}
+
+func (twoSum) Func() interface{} { return solveTwoSum }
+
+func solveTwoSum(nums []int, target int) []int {
+	seen := make(map[int]int)
+	for i, num := range nums {
+		if j, ok := seen[target-num]; ok {
+			return []int{j, i}
+		}
+		seen[num] = i
+	}
+	return []int{}
+}
@@ -1,14 +0,0 @@
-package main
-
-type Solution3 struct{}
-
-func (s *Solution) TwoSum3(nums []int, target int) []int {
-	seen := make(map[int]int)
-	for i, num := range nums {
-		if j, ok := seen[target-num]; ok {
-			return []int{j, i}
-		}
-		seen[num] = i
-	}
-	return []int{}
-}
@@ -0,0 +1,211 @@
+// Package testrunner drives registered problems.Problem solutions against
+// LeetCode-companion style .txt fixtures and reports pass/fail results.
+package testrunner
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	leetcode "github.com/test-aitest/test-codehorse/.github/leetcode-runner/helpers/go"
+	"github.com/test-aitest/test-codehorse/problems"
+)
+
+// Testcase is a single parsed fixture entry: one Inputs string per solution
+// argument, in declaration order, plus the expected formatted output.
+type Testcase struct {
+	Inputs []string
+	Output string
+}
+
+// ParseFixture reads a fixture file in the format used across
+// LeetCode-companion repos: each block has one `input:` line per solution
+// argument and a trailing `output:` line, with blocks separated by a `---`
+// line.
+func ParseFixture(path string) ([]Testcase, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var cases []Testcase
+	var cur Testcase
+	hasCur := false
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case line == "---":
+			if hasCur {
+				cases = append(cases, cur)
+			}
+			cur = Testcase{}
+			hasCur = false
+		case strings.HasPrefix(line, "input:"):
+			cur.Inputs = append(cur.Inputs, strings.TrimSpace(strings.TrimPrefix(line, "input:")))
+			hasCur = true
+		case strings.HasPrefix(line, "output:"):
+			cur.Output = strings.TrimSpace(strings.TrimPrefix(line, "output:"))
+			hasCur = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if hasCur {
+		cases = append(cases, cur)
+	}
+
+	return cases, nil
+}
+
+// Result is the outcome of running a single Testcase against a registered
+// Problem.
+type Result struct {
+	Testcase Testcase
+	Got      string
+	Pass     bool
+	Diff     string
+}
+
+// Summary aggregates the results of running a fixture file.
+type Summary struct {
+	Total   int
+	Passed  int
+	Failed  int
+	Results []Result
+}
+
+// RunFixture loads the fixture at path and runs every testcase against the
+// problem registered under id.
+func RunFixture(id int, path string) (Summary, error) {
+	p, ok := problems.Get(id)
+	if !ok {
+		return Summary{}, fmt.Errorf("testrunner: no solution registered for problem %d", id)
+	}
+
+	cases, err := ParseFixture(path)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	sig := p.Signature()
+
+	var summary Summary
+	for _, tc := range cases {
+		got, err := invoke(p, sig, tc.Inputs)
+		if err != nil {
+			got = fmt.Sprintf("error: %v", err)
+		}
+
+		pass, diff := compare(sig.Returns, tc.Output, got)
+		result := Result{Testcase: tc, Got: got, Pass: pass, Diff: diff}
+		summary.Results = append(summary.Results, result)
+		summary.Total++
+		if result.Pass {
+			summary.Passed++
+		} else {
+			summary.Failed++
+		}
+	}
+
+	return summary, nil
+}
+
+// invoke reflectively coerces inputs to the parameter types declared in
+// sig.Params and calls p.Func() with them, returning the formatted result.
+// This drives the solution directly rather than going through p.Invoke, so a
+// fixture exercises the same argument parsing testrunner itself is
+// responsible for.
+func invoke(p problems.Problem, sig problems.FuncSig, inputs []string) (string, error) {
+	if len(inputs) != len(sig.Params) {
+		return "", fmt.Errorf("testrunner: problem %d expects %d inputs, got %d", p.ID(), len(sig.Params), len(inputs))
+	}
+
+	fn := reflect.ValueOf(p.Func())
+	args := make([]reflect.Value, len(sig.Params))
+	for i, typeName := range sig.Params {
+		arg, err := coerce(inputs[i], typeName)
+		if err != nil {
+			return "", err
+		}
+		args[i] = arg
+	}
+
+	out := fn.Call(args)
+	if len(out) == 0 {
+		return "", fmt.Errorf("testrunner: problem %d's solution returned no values", p.ID())
+	}
+
+	return leetcode.FormatOutput(out[0].Interface()), nil
+}
+
+// coerce parses a raw fixture argument string into the Go value a solution
+// parameter expects, per the type vocabulary used by FuncSig.Params
+// ([]int, [][]int, *ListNode, *TreeNode, int, string, bool).
+func coerce(s, typeName string) (reflect.Value, error) {
+	switch typeName {
+	case "int":
+		return reflect.ValueOf(leetcode.ParseInt(s)), nil
+	case "string":
+		return reflect.ValueOf(leetcode.ParseString(s)), nil
+	case "bool":
+		return reflect.ValueOf(leetcode.ParseBool(s)), nil
+	case "[]int":
+		return reflect.ValueOf(leetcode.ParseIntList(s)), nil
+	case "[][]int":
+		return reflect.ValueOf(leetcode.ParseIntMatrix(s)), nil
+	case "*ListNode":
+		return reflect.ValueOf(leetcode.ParseLinkedList(s)), nil
+	case "*TreeNode":
+		return reflect.ValueOf(leetcode.ParseTree(s)), nil
+	default:
+		return reflect.Value{}, fmt.Errorf("testrunner: no coercion for parameter type %q", typeName)
+	}
+}
+
+// compare checks expected against got according to the solution's declared
+// return type. Trees and lists are compared structurally (tolerating
+// trailing-null differences in the formatted string), everything else falls
+// back to a plain string compare.
+func compare(returns []string, expected, got string) (pass bool, diff string) {
+	if len(returns) != 1 {
+		return expected == got, ""
+	}
+
+	switch returns[0] {
+	case "*TreeNode":
+		wantTree := leetcode.ParseTree(expected)
+		gotTree := leetcode.ParseTree(got)
+		return leetcode.EqualTree(wantTree, gotTree), leetcode.DiffTree(wantTree, gotTree)
+	case "*ListNode":
+		wantList := leetcode.ParseLinkedList(expected)
+		gotList := leetcode.ParseLinkedList(got)
+		return leetcode.EqualList(wantList, gotList), ""
+	default:
+		return expected == got, ""
+	}
+}
+
+// Report renders a human-readable pass/fail summary, with a unified-style
+// diff line for every mismatch.
+func Report(summary Summary) string {
+	var b strings.Builder
+	for i, r := range summary.Results {
+		if r.Pass {
+			continue
+		}
+		fmt.Fprintf(&b, "FAIL case %d:\n- expected: %s\n+ got:      %s\n", i+1, r.Testcase.Output, r.Got)
+		if r.Diff != "" {
+			fmt.Fprintf(&b, "%s\n", r.Diff)
+		}
+	}
+	fmt.Fprintf(&b, "%d/%d passed\n", summary.Passed, summary.Total)
+	return b.String()
+}
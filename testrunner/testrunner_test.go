@@ -0,0 +1,42 @@
+package testrunner
+
+import "testing"
+
+const twoSumFixture = "testdata/two_sum.txt"
+
+func TestParseFixture(t *testing.T) {
+	cases, err := ParseFixture(twoSumFixture)
+	if err != nil {
+		t.Fatalf("ParseFixture: %v", err)
+	}
+
+	if len(cases) != 3 {
+		t.Fatalf("expected 3 testcases, got %d", len(cases))
+	}
+
+	want := Testcase{Inputs: []string{"[2,7,11,15]", "9"}, Output: "[0,1]"}
+	if cases[0].Output != want.Output || len(cases[0].Inputs) != len(want.Inputs) ||
+		cases[0].Inputs[0] != want.Inputs[0] || cases[0].Inputs[1] != want.Inputs[1] {
+		t.Fatalf("case 0 = %+v, want %+v", cases[0], want)
+	}
+}
+
+func TestRunFixture(t *testing.T) {
+	summary, err := RunFixture(1, twoSumFixture)
+	if err != nil {
+		t.Fatalf("RunFixture: %v", err)
+	}
+
+	if summary.Total != 3 {
+		t.Fatalf("expected 3 cases, got %d", summary.Total)
+	}
+	if summary.Failed != 0 {
+		t.Fatalf("expected all cases to pass, got %d failures:\n%s", summary.Failed, Report(summary))
+	}
+}
+
+func TestRunFixtureUnknownProblem(t *testing.T) {
+	if _, err := RunFixture(999999, twoSumFixture); err == nil {
+		t.Fatal("expected an error for an unregistered problem ID")
+	}
+}